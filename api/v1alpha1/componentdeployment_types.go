@@ -30,6 +30,13 @@ type ComponentDeploymentSpec struct {
 	// Structure: map[instanceName]overrideValues
 	// +optional
 	AddonOverrides map[string]runtime.RawExtension `json:"addonOverrides,omitempty"`
+
+	// ReleaseName overrides the name of the Release generated for this deployment
+	// Defaults to the name of the ComponentDeployment
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`
+	ReleaseName string `json:"releaseName,omitempty"`
 }
 
 // ComponentDeploymentOwner identifies the component this ComponentDeployment applies to
@@ -54,6 +61,14 @@ type ComponentDeploymentStatus struct {
 	// Conditions represent the latest available observations of the ComponentDeployment's state
 	// +optional
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ReleaseName is the name of the Release generated for this ComponentDeployment
+	// +optional
+	ReleaseName string `json:"releaseName,omitempty"`
+
+	// RenderedResourceCount is the number of Kubernetes resources rendered into the Release
+	// +optional
+	RenderedResourceCount int `json:"renderedResourceCount,omitempty"`
 }
 
 // +kubebuilder:object:root=true
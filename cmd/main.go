@@ -86,6 +86,7 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var enableLegacyCRDs bool
+	var componentDeploymentServerSideApply bool
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
@@ -99,6 +100,9 @@ func main() {
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
 	flag.BoolVar(&enableLegacyCRDs, "enable-legacy-crds", false, // TODO <-- remove me
 		"If set, legacy CRDs will be enabled. This is only for the POC and will be removed in the future.")
+	flag.BoolVar(&componentDeploymentServerSideApply, "componentdeployment-server-side-apply", false,
+		"If set, the ComponentDeployment controller applies Releases with server-side apply "+
+			"to preserve the fields managed by other controllers.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -279,8 +283,9 @@ func main() {
 
 	// ComponentDeployment controller
 	if err = (&componentdeployment.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:             mgr.GetClient(),
+		Scheme:             mgr.GetScheme(),
+		UseServerSideApply: componentDeploymentServerSideApply,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ComponentDeployment")
 		os.Exit(1)
@@ -12,10 +12,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -27,10 +30,19 @@ import (
 	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
+const (
+	// ControllerName is the name of the controller managing ComponentDeployment resources
+	ControllerName = "componentdeployment-controller"
+)
+
 // Reconciler reconciles an ComponentDeployment object
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+	// UseServerSideApply makes the controller apply the Release with server-side apply instead of
+	// overwriting it, so that fields managed by other controllers are preserved
+	UseServerSideApply bool
+	recorder           record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentdeployments,verbs=get;list;watch;create;update;patch;delete
@@ -39,6 +51,7 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=componentenvsnapshots,verbs=get;list;watch
 // +kubebuilder:rbac:groups=openchoreo.dev,resources=releases,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 
 // Reconcile is part of the main kubernetes reconciliation loop
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, rErr error) {
@@ -145,10 +158,7 @@ func (r *Reconciler) validateSnapshot(snapshot *openchoreov1alpha1.ComponentEnvS
 		return fmt.Errorf("component name is empty")
 	}
 
-	// Check Workload is present
-	if snapshot.Spec.Workload.Name == "" {
-		return fmt.Errorf("workload name is empty")
-	}
+	// A missing Workload is allowed; such a partial snapshot renders only the ComponentTypeDefinition resources
 
 	// Check required owner fields
 	if snapshot.Spec.Owner.ProjectName == "" {
@@ -162,7 +172,7 @@ func (r *Reconciler) validateSnapshot(snapshot *openchoreov1alpha1.ComponentEnvS
 }
 
 // reconcileRelease creates or updates the Release resource
-func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *openchoreov1alpha1.ComponentDeployment, snapshot *openchoreov1alpha1.ComponentEnvSnapshot) error { //nolint:unparam // snapshot will be used when rendering pipeline is implemented
+func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *openchoreov1alpha1.ComponentDeployment, snapshot *openchoreov1alpha1.ComponentEnvSnapshot) error {
 	logger := log.FromContext(ctx)
 
 	// TODO: Use componentDeployment and snapshot data to generate actual resources.
@@ -193,6 +203,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 		msg := fmt.Sprintf("Failed to marshal resources: %v", err)
 		controller.MarkFalseCondition(componentDeployment, ConditionReady,
 			ReasonRenderingFailed, msg)
+		r.recordEvent(componentDeployment, corev1.EventTypeWarning, string(ReasonRenderingFailed), msg)
 		return fmt.Errorf("failed to marshal configmap: %w", err)
 	}
 
@@ -204,47 +215,36 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 		},
 	}
 
+	releaseLabels := buildReleaseLabels(componentDeployment)
+
+	// Label every rendered resource so it can be traced back to its component on the data plane
+	if err := injectReleaseLabels(releaseResources, releaseLabels); err != nil {
+		msg := fmt.Sprintf("Failed to label resources: %v", err)
+		controller.MarkFalseCondition(componentDeployment, ConditionReady,
+			ReasonRenderingFailed, msg)
+		r.recordEvent(componentDeployment, corev1.EventTypeWarning, string(ReasonRenderingFailed), msg)
+		return fmt.Errorf("failed to label release resources: %w", err)
+	}
+
 	// Create or update Release
 	release := &openchoreov1alpha1.Release{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      componentDeployment.Name,
+			Name:      r.buildReleaseName(componentDeployment),
 			Namespace: componentDeployment.Namespace,
 		},
 	}
 
-	op, err := controllerutil.CreateOrUpdate(ctx, r.Client, release, func() error {
-		// Check if we own this Release
-		if release.UID != "" {
-			hasOwner, err := controllerutil.HasOwnerReference(release.GetOwnerReferences(), componentDeployment, r.Scheme)
-			if err != nil {
-				return fmt.Errorf("failed to check owner reference: %w", err)
-			}
-			if !hasOwner {
-				// Release exists but not owned by us
-				return fmt.Errorf("release exists but is not owned by this ComponentDeployment")
+	var op controllerutil.OperationResult
+	if r.UseServerSideApply {
+		op, err = r.applyRelease(ctx, componentDeployment, release, releaseLabels, releaseResources)
+	} else {
+		op, err = controllerutil.CreateOrUpdate(ctx, r.Client, release, func() error {
+			if err := r.checkReleaseOwnership(componentDeployment, release); err != nil {
+				return err
 			}
-		}
-
-		// Set labels (replace entire map to ensure old labels don't persist)
-		release.Labels = map[string]string{
-			labels.LabelKeyOrganizationName: componentDeployment.Namespace,
-			labels.LabelKeyProjectName:      componentDeployment.Spec.Owner.ProjectName,
-			labels.LabelKeyComponentName:    componentDeployment.Spec.Owner.ComponentName,
-			labels.LabelKeyEnvironmentName:  componentDeployment.Spec.Environment,
-		}
-
-		// Set spec
-		release.Spec = openchoreov1alpha1.ReleaseSpec{
-			Owner: openchoreov1alpha1.ReleaseOwner{
-				ProjectName:   componentDeployment.Spec.Owner.ProjectName,
-				ComponentName: componentDeployment.Spec.Owner.ComponentName,
-			},
-			EnvironmentName: componentDeployment.Spec.Environment,
-			Resources:       releaseResources,
-		}
-
-		return controllerutil.SetControllerReference(componentDeployment, release, r.Scheme)
-	})
+			return r.setReleaseDesiredState(componentDeployment, release, releaseLabels, releaseResources)
+		})
+	}
 
 	if err != nil {
 		// Check for ownership conflict (permanent error - don't retry)
@@ -252,6 +252,7 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 			msg := fmt.Sprintf("Release %q exists but is owned by another resource", release.Name)
 			controller.MarkFalseCondition(componentDeployment, ConditionReady,
 				ReasonReleaseOwnershipConflict, msg)
+			r.recordEvent(componentDeployment, corev1.EventTypeWarning, string(ReasonReleaseOwnershipConflict), msg)
 			logger.Error(err, msg)
 			return nil
 		}
@@ -265,29 +266,228 @@ func (r *Reconciler) reconcileRelease(ctx context.Context, componentDeployment *
 		}
 		msg := fmt.Sprintf("Failed to reconcile Release: %v", err)
 		controller.MarkFalseCondition(componentDeployment, ConditionReady, reason, msg)
+		r.recordEvent(componentDeployment, corev1.EventTypeWarning, string(reason), msg)
 		logger.Error(err, "Failed to reconcile Release", "release", release.Name)
 		return err
 	}
 
-	// Success - mark as ready
-	if op == controllerutil.OperationResultCreated ||
-		op == controllerutil.OperationResultUpdated {
-		msg := fmt.Sprintf("Release %q successfully %s with %d resources",
-			release.Name, op, len(releaseResources))
-		controller.MarkTrueCondition(componentDeployment, ConditionReady, ReasonReleaseReady, msg)
-		logger.Info("Successfully reconciled Release",
-			"release", release.Name,
-			"operation", op,
-			"resourceCount", len(releaseResources))
+	// Delete the Release generated under the previous name when the release name has changed
+	if err := r.deletePreviousRelease(ctx, componentDeployment, release.Name); err != nil {
+		logger.Error(err, "Failed to delete the previous Release",
+			"release", componentDeployment.Status.ReleaseName)
+		return err
+	}
+
+	// Record the generated Release; the deferred status update persists these fields
+	componentDeployment.Status.ReleaseName = release.Name
+	componentDeployment.Status.RenderedResourceCount = len(releaseResources)
+
+	switch op {
+	case controllerutil.OperationResultCreated:
+		r.recordEvent(componentDeployment, corev1.EventTypeNormal, EventReasonReleaseCreated,
+			fmt.Sprintf("Release %q created with %d resources", release.Name, len(releaseResources)))
+	case controllerutil.OperationResultUpdated:
+		r.recordEvent(componentDeployment, corev1.EventTypeNormal, EventReasonReleaseUpdated,
+			fmt.Sprintf("Release %q updated with %d resources", release.Name, len(releaseResources)))
+	}
+
+	// Success - mark as ready, degraded to a partial snapshot reason when the snapshot has no workload
+	reason := ReasonReleaseReady
+	msg := fmt.Sprintf("Release %q is ready with %d resources", release.Name, len(releaseResources))
+	if isPartialSnapshot(snapshot) {
+		reason = ReasonPartialSnapshot
+		msg = fmt.Sprintf("Release %q is ready with %d resources rendered from a snapshot without a workload",
+			release.Name, len(releaseResources))
+	}
+
+	// Warn only when the ComponentDeployment becomes partial, not on every reconcile of a partial snapshot
+	prevReady := meta.FindStatusCondition(componentDeployment.Status.Conditions, ConditionReady.String())
+	becamePartial := reason == ReasonPartialSnapshot &&
+		(prevReady == nil || prevReady.Reason != string(ReasonPartialSnapshot))
+
+	controller.MarkTrueCondition(componentDeployment, ConditionReady, reason, msg)
+	if becamePartial {
+		r.recordEvent(componentDeployment, corev1.EventTypeWarning, string(ReasonPartialSnapshot), msg)
+	}
+
+	logger.Info("Successfully reconciled Release",
+		"release", release.Name,
+		"operation", op,
+		"resourceCount", len(releaseResources),
+		"partialSnapshot", reason == ReasonPartialSnapshot)
+
+	return nil
+}
+
+// isPartialSnapshot returns true if the snapshot has no Workload.
+// Such a snapshot can only render the resources of its ComponentTypeDefinition.
+func isPartialSnapshot(snapshot *openchoreov1alpha1.ComponentEnvSnapshot) bool {
+	return snapshot.Spec.Workload.Name == ""
+}
+
+// buildReleaseName returns the name of the Release for the given ComponentDeployment
+func (r *Reconciler) buildReleaseName(componentDeployment *openchoreov1alpha1.ComponentDeployment) string {
+	if componentDeployment.Spec.ReleaseName != "" {
+		return componentDeployment.Spec.ReleaseName
+	}
+	return componentDeployment.Name
+}
+
+// deletePreviousRelease deletes the Release recorded in the status when it differs from the current release name.
+// Releases that are not controlled by the ComponentDeployment are left untouched.
+func (r *Reconciler) deletePreviousRelease(ctx context.Context, componentDeployment *openchoreov1alpha1.ComponentDeployment, releaseName string) error {
+	previousName := componentDeployment.Status.ReleaseName
+	if previousName == "" || previousName == releaseName {
+		return nil
+	}
+
+	previous := &openchoreov1alpha1.Release{}
+	if err := r.Get(ctx, types.NamespacedName{
+		Name:      previousName,
+		Namespace: componentDeployment.Namespace,
+	}, previous); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	if !metav1.IsControlledBy(previous, componentDeployment) {
+		return nil
+	}
+
+	if err := r.Delete(ctx, previous); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+	return nil
+}
+
+// checkReleaseOwnership returns an error if the Release already exists but is not owned by the ComponentDeployment
+func (r *Reconciler) checkReleaseOwnership(componentDeployment *openchoreov1alpha1.ComponentDeployment, release *openchoreov1alpha1.Release) error {
+	if release.UID == "" {
+		return nil
+	}
+	hasOwner, err := controllerutil.HasOwnerReference(release.GetOwnerReferences(), componentDeployment, r.Scheme)
+	if err != nil {
+		return fmt.Errorf("failed to check owner reference: %w", err)
+	}
+	if !hasOwner {
+		// Release exists but not owned by us
+		return fmt.Errorf("release exists but is not owned by this ComponentDeployment")
+	}
+	return nil
+}
+
+// setReleaseDesiredState sets the labels, spec and controller reference of the Release managed by the ComponentDeployment
+func (r *Reconciler) setReleaseDesiredState(componentDeployment *openchoreov1alpha1.ComponentDeployment,
+	release *openchoreov1alpha1.Release, releaseLabels map[string]string, releaseResources []openchoreov1alpha1.Resource) error {
+	// Set labels. An update replaces the entire map so that old labels don't persist, while server-side
+	// apply only owns these labels and keeps the ones set by other field managers.
+	release.Labels = releaseLabels
+
+	// Set spec
+	release.Spec = openchoreov1alpha1.ReleaseSpec{
+		Owner: openchoreov1alpha1.ReleaseOwner{
+			ProjectName:   componentDeployment.Spec.Owner.ProjectName,
+			ComponentName: componentDeployment.Spec.Owner.ComponentName,
+		},
+		EnvironmentName: componentDeployment.Spec.Environment,
+		Resources:       releaseResources,
+	}
+
+	return controllerutil.SetControllerReference(componentDeployment, release, r.Scheme)
+}
+
+// applyRelease creates or updates the Release using server-side apply.
+// Only the fields set by this controller are owned by its field manager, so labels and other fields
+// managed by other controllers are preserved.
+func (r *Reconciler) applyRelease(ctx context.Context, componentDeployment *openchoreov1alpha1.ComponentDeployment,
+	release *openchoreov1alpha1.Release, releaseLabels map[string]string,
+	releaseResources []openchoreov1alpha1.Resource) (controllerutil.OperationResult, error) {
+	existing := &openchoreov1alpha1.Release{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(release), existing); client.IgnoreNotFound(err) != nil {
+		return controllerutil.OperationResultNone, err
+	}
+	if err := r.checkReleaseOwnership(componentDeployment, existing); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	release.TypeMeta = metav1.TypeMeta{
+		APIVersion: openchoreov1alpha1.GroupVersion.String(),
+		Kind:       "Release",
+	}
+	if err := r.setReleaseDesiredState(componentDeployment, release, releaseLabels, releaseResources); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	if err := r.Patch(ctx, release, client.Apply, client.FieldOwner(ControllerName), client.ForceOwnership); err != nil {
+		return controllerutil.OperationResultNone, err
+	}
+
+	switch {
+	case existing.UID == "":
+		return controllerutil.OperationResultCreated, nil
+	case existing.ResourceVersion != release.ResourceVersion:
+		return controllerutil.OperationResultUpdated, nil
+	default:
+		return controllerutil.OperationResultNone, nil
 	}
+}
+
+// buildReleaseLabels returns the labels that identify the Release and its resources with the ComponentDeployment owner.
+func buildReleaseLabels(componentDeployment *openchoreov1alpha1.ComponentDeployment) map[string]string {
+	return map[string]string{
+		labels.LabelKeyOrganizationName: componentDeployment.Namespace,
+		labels.LabelKeyProjectName:      componentDeployment.Spec.Owner.ProjectName,
+		labels.LabelKeyComponentName:    componentDeployment.Spec.Owner.ComponentName,
+		labels.LabelKeyEnvironmentName:  componentDeployment.Spec.Environment,
+	}
+}
+
+// injectReleaseLabels merges the given labels into the metadata of each Release resource.
+// Labels already present on a resource are overwritten by the given labels.
+func injectReleaseLabels(resources []openchoreov1alpha1.Resource, releaseLabels map[string]string) error {
+	for i := range resources {
+		if resources[i].Object == nil {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(resources[i].Object.Raw); err != nil {
+			return fmt.Errorf("failed to decode resource %q: %w", resources[i].ID, err)
+		}
 
+		objLabels := obj.GetLabels()
+		if objLabels == nil {
+			objLabels = make(map[string]string, len(releaseLabels))
+		}
+		for k, v := range releaseLabels {
+			objLabels[k] = v
+		}
+		obj.SetLabels(objLabels)
+
+		raw, err := json.Marshal(obj.Object)
+		if err != nil {
+			return fmt.Errorf("failed to encode resource %q: %w", resources[i].ID, err)
+		}
+		resources[i].Object = &runtime.RawExtension{Raw: raw}
+	}
 	return nil
 }
 
+// recordEvent records an event for the ComponentDeployment.
+// Events are skipped when the reconciler is not set up with a manager and has no event recorder.
+func (r *Reconciler) recordEvent(componentDeployment *openchoreov1alpha1.ComponentDeployment, eventType, reason, message string) {
+	if r.recorder == nil {
+		return
+	}
+	r.recorder.Event(componentDeployment, eventType, reason, message)
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	ctx := context.Background()
 
+	if r.recorder == nil {
+		r.recorder = mgr.GetEventRecorderFor(ControllerName)
+	}
+
 	if err := r.setupComponentIndex(ctx, mgr); err != nil {
 		return err
 	}
@@ -22,6 +22,8 @@ const (
 
 	// ReasonReleaseReady indicates the Release is successfully deployed and ready
 	ReasonReleaseReady controller.ConditionReason = "ReleaseReady"
+	// ReasonPartialSnapshot indicates the Release is ready but was rendered from a snapshot without a Workload
+	ReasonPartialSnapshot controller.ConditionReason = "PartialSnapshot"
 
 	// Configuration issues (Status=False)
 
@@ -55,3 +57,12 @@ const (
 	// ReasonResourcesDegraded indicates one or more resources are in error state
 	ReasonResourcesDegraded controller.ConditionReason = "ResourcesDegraded"
 )
+
+// Constants for event reasons
+
+const (
+	// EventReasonReleaseCreated indicates the Release was created for the ComponentDeployment
+	EventReasonReleaseCreated = "ReleaseCreated"
+	// EventReasonReleaseUpdated indicates the Release of the ComponentDeployment was updated
+	EventReasonReleaseUpdated = "ReleaseUpdated"
+)
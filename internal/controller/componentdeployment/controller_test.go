@@ -9,13 +9,72 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/labels"
 )
 
+// newTestSnapshot builds a ComponentEnvSnapshot that satisfies the ComponentDeployment controller validation.
+func newTestSnapshot(name, namespace, projectName, componentName, environment string) *openchoreov1alpha1.ComponentEnvSnapshot {
+	return &openchoreov1alpha1.ComponentEnvSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: openchoreov1alpha1.ComponentEnvSnapshotSpec{
+			Owner: openchoreov1alpha1.ComponentEnvSnapshotOwner{
+				ProjectName:   projectName,
+				ComponentName: componentName,
+			},
+			Environment: environment,
+			ComponentTypeDefinition: openchoreov1alpha1.ComponentTypeDefinition{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-service",
+				},
+				Spec: openchoreov1alpha1.ComponentTypeDefinitionSpec{
+					WorkloadType: "deployment",
+					Resources: []openchoreov1alpha1.ResourceTemplate{
+						{
+							ID:       "deployment",
+							Template: &runtime.RawExtension{Raw: []byte(`{"apiVersion":"apps/v1","kind":"Deployment"}`)},
+						},
+					},
+				},
+			},
+			Component: openchoreov1alpha1.Component{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: componentName,
+				},
+				Spec: openchoreov1alpha1.ComponentSpec{
+					Owner: openchoreov1alpha1.ComponentOwner{
+						ProjectName: projectName,
+					},
+					ComponentType: "deployment/test-service",
+				},
+			},
+			Workload: openchoreov1alpha1.Workload{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: componentName + "-workload",
+				},
+				Spec: openchoreov1alpha1.WorkloadSpec{
+					Owner: openchoreov1alpha1.WorkloadOwner{
+						ProjectName:   projectName,
+						ComponentName: componentName,
+					},
+				},
+			},
+		},
+	}
+}
+
 var _ = Describe("ComponentDeployment Controller", func() {
 	Context("When reconciling an ComponentDeployment resource", func() {
 		const componentDeploymentName = "test-componentdeployments"
@@ -65,5 +124,323 @@ var _ = Describe("ComponentDeployment Controller", func() {
 			By("Cleaning up the ComponentDeployment resource")
 			Expect(k8sClient.Delete(ctx, componentDeployment)).To(Succeed())
 		})
+
+		Context("When the ComponentEnvSnapshot exists", func() {
+			const (
+				componentDeploymentName = "test-component-dev"
+				componentName           = "test-component"
+				environment             = "dev"
+			)
+
+			componentDeploymentNamespacedName := types.NamespacedName{
+				Name:      componentDeploymentName,
+				Namespace: namespace,
+			}
+
+			var (
+				componentDeployment *openchoreov1alpha1.ComponentDeployment
+				recorder            *record.FakeRecorder
+				reconciler          *Reconciler
+			)
+
+			BeforeEach(func() {
+				Expect(k8sClient.Create(ctx, newTestSnapshot(componentName+"-"+environment, namespace,
+					"test-project", componentName, environment))).To(Succeed())
+
+				componentDeployment = &openchoreov1alpha1.ComponentDeployment{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      componentDeploymentName,
+						Namespace: namespace,
+					},
+					Spec: openchoreov1alpha1.ComponentDeploymentSpec{
+						Owner: openchoreov1alpha1.ComponentDeploymentOwner{
+							ProjectName:   "test-project",
+							ComponentName: componentName,
+						},
+						Environment: environment,
+					},
+				}
+				Expect(k8sClient.Create(ctx, componentDeployment)).To(Succeed())
+
+				recorder = record.NewFakeRecorder(10)
+				reconciler = &Reconciler{
+					Client:   k8sClient,
+					Scheme:   k8sClient.Scheme(),
+					recorder: recorder,
+				}
+			})
+
+			AfterEach(func() {
+				snapshot := &openchoreov1alpha1.ComponentEnvSnapshot{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Name: componentName + "-" + environment, Namespace: namespace}, snapshot); err == nil {
+					Expect(k8sClient.Delete(ctx, snapshot)).To(Succeed())
+				}
+				release := &openchoreov1alpha1.Release{}
+				if err := k8sClient.Get(ctx, componentDeploymentNamespacedName, release); err == nil {
+					Expect(k8sClient.Delete(ctx, release)).To(Succeed())
+				}
+				if err := k8sClient.Get(ctx, componentDeploymentNamespacedName, componentDeployment); err == nil {
+					Expect(k8sClient.Delete(ctx, componentDeployment)).To(Succeed())
+				}
+			})
+
+			It("should emit events when the Release is created and updated", func() {
+				By("Reconciling to create the Release")
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonReleaseCreated)))
+
+				By("Changing the Release so that the next reconcile updates it")
+				release := &openchoreov1alpha1.Release{}
+				Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, release)).To(Succeed())
+				release.Spec.EnvironmentName = "stale"
+				Expect(k8sClient.Update(ctx, release)).To(Succeed())
+
+				_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonReleaseUpdated)))
+			})
+
+			It("should reconcile the Release without an event recorder", func() {
+				reconciler.recorder = nil
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, &openchoreov1alpha1.Release{})).To(Succeed())
+			})
+
+			It("should record the Release name and rendered resource count in the status", func() {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+
+				updated := &openchoreov1alpha1.ComponentDeployment{}
+				Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, updated)).To(Succeed())
+				Expect(updated.Status.ReleaseName).To(Equal(componentDeploymentName))
+				Expect(updated.Status.RenderedResourceCount).To(Equal(1))
+			})
+
+			It("should label every Release resource with the owner labels", func() {
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+
+				release := &openchoreov1alpha1.Release{}
+				Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, release)).To(Succeed())
+				Expect(release.Spec.Resources).NotTo(BeEmpty())
+				for _, resource := range release.Spec.Resources {
+					obj := &unstructured.Unstructured{}
+					Expect(obj.UnmarshalJSON(resource.Object.Raw)).To(Succeed())
+					Expect(obj.GetLabels()).To(And(
+						HaveKeyWithValue(labels.LabelKeyOrganizationName, namespace),
+						HaveKeyWithValue(labels.LabelKeyProjectName, "test-project"),
+						HaveKeyWithValue(labels.LabelKeyComponentName, componentName),
+						HaveKeyWithValue(labels.LabelKeyEnvironmentName, environment),
+					))
+				}
+			})
+
+			It("should create the Release from a snapshot without a workload", func() {
+				By("Removing the workload from the snapshot")
+				snapshot := &openchoreov1alpha1.ComponentEnvSnapshot{}
+				Expect(k8sClient.Get(ctx, types.NamespacedName{Name: componentName + "-" + environment, Namespace: namespace}, snapshot)).To(Succeed())
+				snapshot.Spec.Workload.ObjectMeta = metav1.ObjectMeta{}
+				Expect(k8sClient.Update(ctx, snapshot)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+
+				By("Checking the Release is created")
+				release := &openchoreov1alpha1.Release{}
+				Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, release)).To(Succeed())
+				Expect(release.Spec.Resources).NotTo(BeEmpty())
+
+				By("Checking the ComponentDeployment is ready with a partial snapshot")
+				updated := &openchoreov1alpha1.ComponentDeployment{}
+				Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, updated)).To(Succeed())
+				cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionReady.String())
+				Expect(cond).NotTo(BeNil())
+				Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				Expect(cond.Reason).To(Equal(string(ReasonPartialSnapshot)))
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonReleaseCreated)))
+				Expect(recorder.Events).To(Receive(And(
+					ContainSubstring("Warning"),
+					ContainSubstring(string(ReasonPartialSnapshot)),
+				)))
+			})
+
+			It("should warn once about a partial snapshot and recover when the workload is added", func() {
+				snapshotName := types.NamespacedName{Name: componentName + "-" + environment, Namespace: namespace}
+				snapshot := &openchoreov1alpha1.ComponentEnvSnapshot{}
+				Expect(k8sClient.Get(ctx, snapshotName, snapshot)).To(Succeed())
+				workloadMeta := snapshot.Spec.Workload.ObjectMeta
+				snapshot.Spec.Workload.ObjectMeta = metav1.ObjectMeta{}
+				Expect(k8sClient.Update(ctx, snapshot)).To(Succeed())
+
+				By("Reconciling the partial snapshot twice")
+				for range 2 {
+					_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+					Expect(err).NotTo(HaveOccurred())
+				}
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonReleaseCreated)))
+				Expect(recorder.Events).To(Receive(ContainSubstring(string(ReasonPartialSnapshot))))
+				Expect(recorder.Events).NotTo(Receive())
+
+				By("Adding the workload back to the snapshot")
+				Expect(k8sClient.Get(ctx, snapshotName, snapshot)).To(Succeed())
+				snapshot.Spec.Workload.ObjectMeta = workloadMeta
+				Expect(k8sClient.Update(ctx, snapshot)).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+
+				updated := &openchoreov1alpha1.ComponentDeployment{}
+				Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, updated)).To(Succeed())
+				cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionReady.String())
+				Expect(cond).NotTo(BeNil())
+				Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+				Expect(cond.Reason).To(Equal(string(ReasonReleaseReady)))
+			})
+
+			It("should preserve the Release fields managed by others when using server-side apply", func() {
+				reconciler.UseServerSideApply = true
+
+				By("Creating the Release with server-side apply")
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(ContainSubstring(EventReasonReleaseCreated)))
+
+				By("Applying a label to the Release with another field manager")
+				Expect(k8sClient.Patch(ctx, &openchoreov1alpha1.Release{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: openchoreov1alpha1.GroupVersion.String(),
+						Kind:       "Release",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      componentDeploymentName,
+						Namespace: namespace,
+						Labels:    map[string]string{"example.com/team": "payments"},
+					},
+				}, client.Apply, client.FieldOwner("other-controller"))).To(Succeed())
+
+				By("Reconciling the Release again")
+				_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+
+				release := &openchoreov1alpha1.Release{}
+				Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, release)).To(Succeed())
+				Expect(release.Labels).To(HaveKeyWithValue("example.com/team", "payments"))
+				Expect(release.Labels).To(HaveKeyWithValue(labels.LabelKeyComponentName, componentName))
+				Expect(metav1.IsControlledBy(release, componentDeployment)).To(BeTrue())
+
+				managers := make([]string, 0, len(release.ManagedFields))
+				for _, entry := range release.ManagedFields {
+					managers = append(managers, entry.Manager)
+				}
+				Expect(managers).To(ContainElements(ControllerName, "other-controller"))
+			})
+
+			It("should emit a warning event when the Release is owned by another resource", func() {
+				Expect(k8sClient.Create(ctx, &openchoreov1alpha1.Release{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      componentDeploymentName,
+						Namespace: namespace,
+					},
+					Spec: openchoreov1alpha1.ReleaseSpec{
+						Owner: openchoreov1alpha1.ReleaseOwner{
+							ProjectName:   "test-project",
+							ComponentName: componentName,
+						},
+						EnvironmentName: environment,
+					},
+				})).To(Succeed())
+
+				_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(recorder.Events).To(Receive(And(
+					ContainSubstring("Warning"),
+					ContainSubstring(string(ReasonReleaseOwnershipConflict)),
+				)))
+			})
+
+			Context("When a custom release name is set", func() {
+				const releaseName = "test-component-dev-custom"
+
+				releaseNamespacedName := types.NamespacedName{
+					Name:      releaseName,
+					Namespace: namespace,
+				}
+
+				BeforeEach(func() {
+					componentDeployment.Spec.ReleaseName = releaseName
+					Expect(k8sClient.Update(ctx, componentDeployment)).To(Succeed())
+					DeferCleanup(func() {
+						release := &openchoreov1alpha1.Release{}
+						if err := k8sClient.Get(ctx, releaseNamespacedName, release); err == nil {
+							Expect(k8sClient.Delete(ctx, release)).To(Succeed())
+						}
+					})
+				})
+
+				It("should create the Release with the custom name", func() {
+					_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+					Expect(err).NotTo(HaveOccurred())
+
+					release := &openchoreov1alpha1.Release{}
+					Expect(k8sClient.Get(ctx, releaseNamespacedName, release)).To(Succeed())
+					Expect(metav1.IsControlledBy(release, componentDeployment)).To(BeTrue())
+					Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, &openchoreov1alpha1.Release{})).
+						To(MatchError(errors.IsNotFound, "IsNotFound"))
+
+					updated := &openchoreov1alpha1.ComponentDeployment{}
+					Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, updated)).To(Succeed())
+					Expect(updated.Status.ReleaseName).To(Equal(releaseName))
+				})
+
+				It("should not take over a Release with the custom name owned by another resource", func() {
+					Expect(k8sClient.Create(ctx, &openchoreov1alpha1.Release{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      releaseName,
+							Namespace: namespace,
+						},
+						Spec: openchoreov1alpha1.ReleaseSpec{
+							Owner: openchoreov1alpha1.ReleaseOwner{
+								ProjectName:   "test-project",
+								ComponentName: componentName,
+							},
+							EnvironmentName: environment,
+						},
+					})).To(Succeed())
+
+					_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+					Expect(err).NotTo(HaveOccurred())
+
+					updated := &openchoreov1alpha1.ComponentDeployment{}
+					Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, updated)).To(Succeed())
+					cond := meta.FindStatusCondition(updated.Status.Conditions, ConditionReady.String())
+					Expect(cond).NotTo(BeNil())
+					Expect(cond.Reason).To(Equal(string(ReasonReleaseOwnershipConflict)))
+				})
+
+				It("should delete the previous Release when the release name changes", func() {
+					By("Reconciling with the default release name")
+					componentDeployment.Spec.ReleaseName = ""
+					Expect(k8sClient.Update(ctx, componentDeployment)).To(Succeed())
+					_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, &openchoreov1alpha1.Release{})).To(Succeed())
+
+					By("Reconciling with the custom release name")
+					Expect(k8sClient.Get(ctx, componentDeploymentNamespacedName, componentDeployment)).To(Succeed())
+					componentDeployment.Spec.ReleaseName = releaseName
+					Expect(k8sClient.Update(ctx, componentDeployment)).To(Succeed())
+					_, err = reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: componentDeploymentNamespacedName})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(k8sClient.Get(ctx, releaseNamespacedName, &openchoreov1alpha1.Release{})).To(Succeed())
+					Eventually(func() error {
+						return k8sClient.Get(ctx, componentDeploymentNamespacedName, &openchoreov1alpha1.Release{})
+					}, time.Second*10, time.Millisecond*500).Should(MatchError(errors.IsNotFound, "IsNotFound"))
+				})
+			})
+		})
 	})
 })
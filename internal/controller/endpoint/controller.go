@@ -7,11 +7,14 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
@@ -26,12 +29,21 @@ import (
 	"github.com/openchoreo/openchoreo/internal/dataplane"
 )
 
+const (
+	// transientErrorBaseDelay is the initial requeue delay after a transient external resource error
+	transientErrorBaseDelay = 5 * time.Second
+	// transientErrorMaxDelay is the upper bound of the requeue delay after repeated transient errors
+	transientErrorMaxDelay = 5 * time.Minute
+)
+
 // Reconciler reconciles a Endpoint object
 type Reconciler struct {
 	client.Client
 	k8sClientMgr *kubernetesClient.KubeMultiClientManager
 	Scheme       *runtime.Scheme
 	recorder     record.EventRecorder
+	// backoff computes the exponential requeue delay for endpoints failing with transient errors
+	backoff workqueue.TypedRateLimiter[ctrl.Request]
 }
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -84,19 +96,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	resourceHandlers := r.makeExternalResourceHandlers(dpClient)
+	resourceHandlers := r.makeExternalResourceHandlers(dpClient, epCtx)
+	staleResourceHandlers := r.makeStaleResourceHandlers(dpClient, epCtx)
 
-	if err = r.reconcileExternalResources(ctx, resourceHandlers, epCtx); err != nil {
-		base := client.MergeFrom(ep.DeepCopy())
-		meta.SetStatusCondition(&ep.Status.Conditions, EndpointFailedExternalReconcileCondition(ep.Generation, err.Error()))
-		logger.Error(err, "failed to reconcile external resources")
-		r.recorder.Eventf(ep, corev1.EventTypeWarning, "ExternalResourceReconciliationFailed",
-			"External resource reconciliation failed: %s", err)
-		if err := r.Client.Patch(ctx, ep, base); err != nil {
-			return ctrl.Result{}, fmt.Errorf("%w, failed to patch endpoint ready condition", err)
-		}
-		return ctrl.Result{}, err
+	if err = r.reconcileExternalResources(ctx, resourceHandlers, staleResourceHandlers, epCtx); err != nil {
+		return r.handleExternalResourceError(ctx, req, ep, err)
 	}
+	r.forgetBackoff(req)
+
 	meta.SetStatusCondition(&ep.Status.Conditions, EndpointReadyCondition(ep.Generation))
 	ep.Status.Address = kubernetes.MakeAddress(epCtx, visibility.GatewayExternal)
 	if ep.Status.Address != old.Status.Address ||
@@ -119,9 +126,33 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	return ctrl.Result{}, nil
 }
 
-func (r *Reconciler) makeExternalResourceHandlers(dpClient client.Client) []dataplane.ResourceHandler[dataplane.EndpointContext] {
-	// Define the resource handlers for the external resources
-	resourceHandlers := []dataplane.ResourceHandler[dataplane.EndpointContext]{
+// makeExternalResourceHandlers returns the resource handlers for the external resources of the endpoint.
+// The handler set is selected based on the endpoint type.
+func (r *Reconciler) makeExternalResourceHandlers(
+	dpClient client.Client,
+	epCtx *dataplane.EndpointContext) []dataplane.ResourceHandler[dataplane.EndpointContext] {
+	if !kubernetes.IsHTTPEndpoint(epCtx.Endpoint) {
+		// TCP and UDP endpoints are not exposed through the gateways yet as there are no
+		// TCPRoute/UDPRoute handlers. Hence, there are no external resources to reconcile.
+		return nil
+	}
+	return makeHTTPResourceHandlers(dpClient)
+}
+
+// makeStaleResourceHandlers returns the resource handlers that are not selected for the endpoint type.
+// The external resources of these handlers are left behind when the endpoint type changes and have to be deleted.
+func (r *Reconciler) makeStaleResourceHandlers(
+	dpClient client.Client,
+	epCtx *dataplane.EndpointContext) []dataplane.ResourceHandler[dataplane.EndpointContext] {
+	if !kubernetes.IsHTTPEndpoint(epCtx.Endpoint) {
+		return makeHTTPResourceHandlers(dpClient)
+	}
+	return nil
+}
+
+// makeHTTPResourceHandlers returns the resource handlers for endpoints that are exposed through HTTPRoutes.
+func makeHTTPResourceHandlers(dpClient client.Client) []dataplane.ResourceHandler[dataplane.EndpointContext] {
+	return []dataplane.ResourceHandler[dataplane.EndpointContext]{
 		k8sintegrations.NewHTTPRouteHandler(dpClient, visibility.NewPublicVisibilityStrategy()),
 		k8sintegrations.NewHTTPRouteHandler(dpClient, visibility.NewOrganizationVisibilityStrategy()),
 		k8sintegrations.NewHTTPRouteFiltersHandler(dpClient, visibility.NewPublicVisibilityStrategy()),
@@ -129,16 +160,75 @@ func (r *Reconciler) makeExternalResourceHandlers(dpClient client.Client) []data
 		k8sintegrations.NewSecurityPolicyHandler(dpClient, visibility.NewPublicVisibilityStrategy()),
 		k8sintegrations.NewSecurityPolicyHandler(dpClient, visibility.NewOrganizationVisibilityStrategy()),
 	}
+}
+
+// handleExternalResourceError marks the endpoint as not ready due to the given external resource
+// reconciliation error and decides how to requeue.
+// Transient errors are requeued with an exponential backoff per endpoint, while other errors are returned
+// so that the controller retries them. The reconcile error is never masked by a failure to patch the status.
+func (r *Reconciler) handleExternalResourceError(ctx context.Context, req ctrl.Request,
+	ep *openchoreov1alpha1.Endpoint, reconcileErr error) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	logger.Error(reconcileErr, "failed to reconcile external resources")
+	r.recorder.Eventf(ep, corev1.EventTypeWarning, "ExternalResourceReconciliationFailed",
+		"External resource reconciliation failed: %s", reconcileErr)
+
+	base := client.MergeFrom(ep.DeepCopy())
+	meta.SetStatusCondition(&ep.Status.Conditions, EndpointFailedExternalReconcileCondition(ep.Generation, reconcileErr.Error()))
+	if err := r.Status().Patch(ctx, ep, base); err != nil {
+		logger.Error(err, "Failed to patch endpoint ready condition")
+		return ctrl.Result{}, fmt.Errorf("%w (failed to patch endpoint ready condition: %v)", reconcileErr, err)
+	}
 
-	return resourceHandlers
+	if isTransientError(reconcileErr) {
+		requeueAfter := transientErrorBaseDelay
+		if r.backoff != nil {
+			requeueAfter = r.backoff.When(req)
+		}
+		logger.Info("Transient error while reconciling external resources, requeuing with backoff",
+			"requeueAfter", requeueAfter)
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
+	// The controller retries other errors with its own rate limiter, so start the backoff over
+	// for the next transient error
+	r.forgetBackoff(req)
+	return ctrl.Result{}, reconcileErr
+}
+
+// forgetBackoff resets the transient error backoff of the endpoint.
+func (r *Reconciler) forgetBackoff(req ctrl.Request) {
+	if r.backoff != nil {
+		r.backoff.Forget(req)
+	}
+}
+
+// isTransientError returns true if the error is likely to be resolved by retrying the same request later.
+func isTransientError(err error) bool {
+	return apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err) ||
+		apierrors.IsConflict(err)
 }
 
 // reconcileExternalResources reconciles the provided external resources based on the deployment context.
+// The external resources of the stale resource handlers are deleted.
 func (r *Reconciler) reconcileExternalResources(
 	ctx context.Context,
 	resourceHandlers []dataplane.ResourceHandler[dataplane.EndpointContext],
+	staleResourceHandlers []dataplane.ResourceHandler[dataplane.EndpointContext],
 	epCtx *dataplane.EndpointContext) error {
 	handlerNameLogKey := "resourceHandler"
+	for _, resourceHandler := range staleResourceHandlers {
+		logger := log.FromContext(ctx).WithValues(handlerNameLogKey, resourceHandler.Name())
+		if err := resourceHandler.Delete(ctx, epCtx); err != nil {
+			logger.Error(err, "Error deleting stale external resource")
+			return err
+		}
+	}
+
 	for _, resourceHandler := range resourceHandlers {
 		logger := log.FromContext(ctx).WithValues(handlerNameLogKey, resourceHandler.Name())
 		// Delete the external resource if it is not configured
@@ -189,6 +279,11 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		r.k8sClientMgr = kubernetesClient.NewManager()
 	}
 
+	if r.backoff == nil {
+		r.backoff = workqueue.NewTypedItemExponentialFailureRateLimiter[ctrl.Request](
+			transientErrorBaseDelay, transientErrorMaxDelay)
+	}
+
 	if err := r.setupDataPlaneRefIndex(context.Background(), mgr); err != nil {
 		return fmt.Errorf("failed to setup dataPlane reference index: %w", err)
 	}
@@ -0,0 +1,180 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/dataplane"
+)
+
+// failingHandler is a resource handler that fails to create the external resource with the given error.
+type failingHandler struct {
+	err error
+}
+
+var _ dataplane.ResourceHandler[dataplane.EndpointContext] = (*failingHandler)(nil)
+
+func (h *failingHandler) Name() string {
+	return "FailingHandler"
+}
+
+func (h *failingHandler) IsRequired(_ *dataplane.EndpointContext) bool {
+	return true
+}
+
+func (h *failingHandler) GetCurrentState(_ context.Context, _ *dataplane.EndpointContext) (interface{}, error) {
+	return nil, nil
+}
+
+func (h *failingHandler) Create(_ context.Context, _ *dataplane.EndpointContext) error {
+	return fmt.Errorf("error while creating resource: %w", h.err)
+}
+
+func (h *failingHandler) Update(_ context.Context, _ *dataplane.EndpointContext, _ interface{}) error {
+	return nil
+}
+
+func (h *failingHandler) Delete(_ context.Context, _ *dataplane.EndpointContext) error {
+	return nil
+}
+
+var _ = Describe("handleExternalResourceError", func() {
+	const resourceName = "backoff-test-endpoint"
+
+	ctx := context.Background()
+
+	typeNamespacedName := types.NamespacedName{
+		Name:      resourceName,
+		Namespace: "default",
+	}
+	req := ctrl.Request{NamespacedName: typeNamespacedName}
+
+	var (
+		ep       *openchoreov1alpha1.Endpoint
+		r        *Reconciler
+		recorder *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		ep = &openchoreov1alpha1.Endpoint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: "default",
+			},
+			Spec: openchoreov1alpha1.EndpointSpec{
+				Type: openchoreov1alpha1.EndpointTypeHTTP,
+				BackendRef: openchoreov1alpha1.BackendRef{
+					Type:     openchoreov1alpha1.BackendRefTypeComponentRef,
+					BasePath: "/",
+					ComponentRef: &openchoreov1alpha1.ComponentRef{
+						Port: 80,
+					},
+				},
+			},
+		}
+		Expect(k8sClient.Create(ctx, ep)).To(Succeed())
+
+		recorder = record.NewFakeRecorder(10)
+		r = &Reconciler{
+			Client:   k8sClient,
+			Scheme:   k8sClient.Scheme(),
+			recorder: recorder,
+			backoff: workqueue.NewTypedItemExponentialFailureRateLimiter[ctrl.Request](
+				transientErrorBaseDelay, transientErrorMaxDelay),
+		}
+	})
+
+	AfterEach(func() {
+		resource := &openchoreov1alpha1.Endpoint{}
+		if err := k8sClient.Get(ctx, typeNamespacedName, resource); err == nil {
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+		}
+	})
+
+	It("should requeue with an increasing backoff when a handler fails with a transient error", func() {
+		transientErr := apierrors.NewServiceUnavailable("dataplane API server is unavailable")
+		handlers := []dataplane.ResourceHandler[dataplane.EndpointContext]{&failingHandler{err: transientErr}}
+		epCtx := &dataplane.EndpointContext{Endpoint: ep}
+
+		reconcileErr := r.reconcileExternalResources(ctx, handlers, nil, epCtx)
+		Expect(reconcileErr).To(HaveOccurred())
+
+		By("requeuing after the base delay on the first failure")
+		result, err := r.handleExternalResourceError(ctx, req, ep, reconcileErr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(transientErrorBaseDelay))
+		Expect(recorder.Events).To(Receive(ContainSubstring("ExternalResourceReconciliationFailed")))
+
+		By("persisting the not ready condition")
+		updated := &openchoreov1alpha1.Endpoint{}
+		Expect(k8sClient.Get(ctx, typeNamespacedName, updated)).To(Succeed())
+		Expect(meta.IsStatusConditionFalse(updated.Status.Conditions, ConditionReady.String())).To(BeTrue())
+
+		By("doubling the delay on the next failure")
+		result, err = r.handleExternalResourceError(ctx, req, updated, reconcileErr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(2 * transientErrorBaseDelay))
+
+		By("resetting the delay once the endpoint is forgotten")
+		r.backoff.Forget(req)
+		result, err = r.handleExternalResourceError(ctx, req, updated, reconcileErr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(transientErrorBaseDelay))
+	})
+
+	It("should return non-transient errors without a backoff requeue", func() {
+		reconcileErr := apierrors.NewForbidden(schema.GroupResource{Resource: "httproutes"}, "route", errors.New("denied"))
+
+		result, err := r.handleExternalResourceError(ctx, req, ep, reconcileErr)
+		Expect(err).To(MatchError(reconcileErr))
+		Expect(result.RequeueAfter).To(BeZero())
+	})
+
+	It("should reset the backoff when a non-transient error follows transient errors", func() {
+		transientErr := apierrors.NewServiceUnavailable("dataplane API server is unavailable")
+		_, err := r.handleExternalResourceError(ctx, req, ep, transientErr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(r.backoff.NumRequeues(req)).To(Equal(1))
+
+		nonTransientErr := apierrors.NewForbidden(schema.GroupResource{Resource: "httproutes"}, "route", errors.New("denied"))
+		_, err = r.handleExternalResourceError(ctx, req, ep, nonTransientErr)
+		Expect(err).To(MatchError(nonTransientErr))
+		Expect(r.backoff.NumRequeues(req)).To(BeZero())
+	})
+
+	It("should requeue after the base delay when the reconciler has no backoff", func() {
+		r.backoff = nil
+		transientErr := apierrors.NewServiceUnavailable("dataplane API server is unavailable")
+
+		result, err := r.handleExternalResourceError(ctx, req, ep, transientErr)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(transientErrorBaseDelay))
+		Expect(r.backoff).To(BeNil())
+	})
+
+	It("should not lose the reconcile error when the status patch fails", func() {
+		Expect(k8sClient.Delete(ctx, ep)).To(Succeed())
+		reconcileErr := apierrors.NewServiceUnavailable("dataplane API server is unavailable")
+
+		_, err := r.handleExternalResourceError(ctx, req, ep, reconcileErr)
+		Expect(err).To(HaveOccurred())
+		Expect(errors.Is(err, reconcileErr)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("failed to patch endpoint ready condition"))
+	})
+})
@@ -57,7 +57,8 @@ func (r *Reconciler) finalize(ctx context.Context, old, ep *openchoreov1alpha1.E
 		return ctrl.Result{}, err
 	}
 
-	resourceHandlers := r.makeExternalResourceHandlers(dpClient)
+	// Include the stale resource handlers to clean up the resources left behind by an endpoint type change
+	resourceHandlers := append(r.makeExternalResourceHandlers(dpClient, epCtx), r.makeStaleResourceHandlers(dpClient, epCtx)...)
 	pendingDeletion := false
 
 	for _, resourceHandler := range resourceHandlers {
@@ -0,0 +1,157 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package endpoint
+
+import (
+	"context"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/dataplane"
+	"github.com/openchoreo/openchoreo/internal/labels"
+)
+
+// newHandlersTestEndpointContext builds an endpoint context for a service component with the given endpoint type.
+func newHandlersTestEndpointContext(endpointType openchoreov1alpha1.EndpointType) *dataplane.EndpointContext {
+	return &dataplane.EndpointContext{
+		Endpoint: &openchoreov1alpha1.Endpoint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-endpoint",
+			},
+			Spec: openchoreov1alpha1.EndpointSpec{
+				Type: endpointType,
+				BackendRef: openchoreov1alpha1.BackendRef{
+					Type:     openchoreov1alpha1.BackendRefTypeComponentRef,
+					BasePath: "/",
+					ComponentRef: &openchoreov1alpha1.ComponentRef{
+						Port: 80,
+					},
+				},
+			},
+		},
+		Component: &openchoreov1alpha1.Component{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-component",
+				Labels: map[string]string{labels.LabelKeyName: "test-component"},
+			},
+			Spec: openchoreov1alpha1.ComponentSpec{
+				Type: openchoreov1alpha1.ComponentTypeService,
+			},
+		},
+		Environment: &openchoreov1alpha1.Environment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-env",
+				Labels: map[string]string{labels.LabelKeyName: "test-env"},
+			},
+			Spec: openchoreov1alpha1.EnvironmentSpec{
+				Gateway: openchoreov1alpha1.GatewayConfig{
+					DNSPrefix: "dev",
+				},
+			},
+		},
+		Project: &openchoreov1alpha1.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-project",
+				Labels: map[string]string{
+					labels.LabelKeyOrganizationName: "test-org",
+					labels.LabelKeyName:             "test-project",
+				},
+			},
+		},
+		DeploymentTrack: &openchoreov1alpha1.DeploymentTrack{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-track",
+				Labels: map[string]string{labels.LabelKeyName: "test-track"},
+			},
+		},
+		Deployment: &openchoreov1alpha1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-deployment",
+				Labels: map[string]string{labels.LabelKeyName: "test-deployment"},
+			},
+		},
+		DataPlane: &openchoreov1alpha1.DataPlane{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "test-dataplane",
+				Labels: map[string]string{labels.LabelKeyName: "test-dataplane"},
+			},
+			Spec: openchoreov1alpha1.DataPlaneSpec{
+				Gateway: openchoreov1alpha1.GatewaySpec{
+					PublicVirtualHost:       "choreoapis.localhost",
+					OrganizationVirtualHost: "internal.choreoapis.localhost",
+				},
+			},
+		},
+	}
+}
+
+// handlerNames returns the names of the given resource handlers.
+func handlerNames(handlers []dataplane.ResourceHandler[dataplane.EndpointContext]) []string {
+	names := make([]string, 0, len(handlers))
+	for _, h := range handlers {
+		names = append(names, h.Name())
+	}
+	return names
+}
+
+var _ = Describe("makeExternalResourceHandlers", func() {
+	httpHandlerNames := []string{
+		"KubernetesHTTPRoutesHandler",
+		"KubernetesHTTPRoutesHandler",
+		"KubernetesHTTPRouteFiltersHandler",
+		"KubernetesHTTPRouteFiltersHandler",
+		"SecurityPolicy",
+		"SecurityPolicy",
+	}
+
+	DescribeTable("should select the resource handlers based on the endpoint type",
+		func(endpointType openchoreov1alpha1.EndpointType, expectedHandlers, expectedStaleHandlers []string) {
+			epCtx := newHandlersTestEndpointContext(endpointType)
+
+			r := &Reconciler{}
+			Expect(handlerNames(r.makeExternalResourceHandlers(nil, epCtx))).To(Equal(expectedHandlers))
+			Expect(handlerNames(r.makeStaleResourceHandlers(nil, epCtx))).To(Equal(expectedStaleHandlers))
+		},
+		Entry("for HTTP endpoints", openchoreov1alpha1.EndpointTypeHTTP, httpHandlerNames, []string{}),
+		Entry("for REST endpoints", openchoreov1alpha1.EndpointTypeREST, httpHandlerNames, []string{}),
+		Entry("for GraphQL endpoints", openchoreov1alpha1.EndpointTypeGraphQL, httpHandlerNames, []string{}),
+		Entry("for Websocket endpoints", openchoreov1alpha1.EndpointTypeWebsocket, httpHandlerNames, []string{}),
+		Entry("for gRPC endpoints", openchoreov1alpha1.EndpointTypeGRPC, httpHandlerNames, []string{}),
+		Entry("for TCP endpoints", openchoreov1alpha1.EndpointTypeTCP, []string{}, httpHandlerNames),
+		Entry("for UDP endpoints", openchoreov1alpha1.EndpointTypeUDP, []string{}, httpHandlerNames),
+	)
+
+	It("should delete the existing HTTPRoutes when an endpoint is changed to TCP", func() {
+		ctx := context.Background()
+
+		dpScheme := runtime.NewScheme()
+		Expect(gwapiv1.Install(dpScheme)).To(Succeed())
+		Expect(egv1a1.AddToScheme(dpScheme)).To(Succeed())
+		dpClient := fake.NewClientBuilder().WithScheme(dpScheme).Build()
+
+		r := &Reconciler{}
+		epCtx := newHandlersTestEndpointContext(openchoreov1alpha1.EndpointTypeHTTP)
+
+		By("Creating the HTTPRoutes for the HTTP endpoint")
+		Expect(r.reconcileExternalResources(ctx, r.makeExternalResourceHandlers(dpClient, epCtx),
+			r.makeStaleResourceHandlers(dpClient, epCtx), epCtx)).To(Succeed())
+		httpRoutes := &gwapiv1.HTTPRouteList{}
+		Expect(dpClient.List(ctx, httpRoutes)).To(Succeed())
+		Expect(httpRoutes.Items).NotTo(BeEmpty())
+
+		By("Reconciling the endpoint as a TCP endpoint")
+		epCtx.Endpoint.Spec.Type = openchoreov1alpha1.EndpointTypeTCP
+		Expect(r.reconcileExternalResources(ctx, r.makeExternalResourceHandlers(dpClient, epCtx),
+			r.makeStaleResourceHandlers(dpClient, epCtx), epCtx)).To(Succeed())
+		Expect(dpClient.List(ctx, httpRoutes)).To(Succeed())
+		Expect(httpRoutes.Items).To(BeEmpty())
+	})
+})
@@ -37,8 +37,33 @@ func makePathPrefix(epCtx *dataplane.EndpointContext) string {
 	return path.Clean(path.Join("/", epCtx.Project.Name, epCtx.Component.Name))
 }
 
-// MakeAddress constructs the full HTTPS URL for an endpoint
+// IsHTTPEndpoint returns true if the endpoint is exposed over HTTP through the gateways.
+// TCP and UDP endpoints are not exposed over HTTP and do not have an HTTP address.
+func IsHTTPEndpoint(ep *openchoreov1alpha1.Endpoint) bool {
+	switch ep.Spec.Type {
+	case openchoreov1alpha1.EndpointTypeTCP, openchoreov1alpha1.EndpointTypeUDP:
+		return false
+	default:
+		return true
+	}
+}
+
+// MakeAddress constructs the full HTTPS URL for an endpoint.
+// Returns an empty string if the endpoint does not have an HTTP address or the context
+// lacks the parent resources required to build it.
 func MakeAddress(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType) string {
+	if epCtx.Endpoint == nil || !IsHTTPEndpoint(epCtx.Endpoint) {
+		return ""
+	}
+	if epCtx.Component == nil || epCtx.Environment == nil {
+		return ""
+	}
+	// Only web applications can build the address without the project and the dataplane gateway
+	if epCtx.Component.Spec.Type != openchoreov1alpha1.ComponentTypeWebApplication &&
+		(epCtx.Project == nil || epCtx.DataPlane == nil) {
+		return ""
+	}
+
 	host := makeHostname(epCtx, gwType)
 	pathPrefix := makePathPrefix(epCtx)
 
@@ -0,0 +1,85 @@
+// Copyright 2025 The OpenChoreo Authors
+// SPDX-License-Identifier: Apache-2.0
+
+package kubernetes
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	openchoreov1alpha1 "github.com/openchoreo/openchoreo/api/v1alpha1"
+	"github.com/openchoreo/openchoreo/internal/controller/endpoint/integrations/kubernetes/visibility"
+	"github.com/openchoreo/openchoreo/internal/dataplane"
+)
+
+var _ = Describe("MakeAddress", func() {
+	newEndpoint := func(endpointType openchoreov1alpha1.EndpointType, backendRef openchoreov1alpha1.BackendRef) *openchoreov1alpha1.Endpoint {
+		return &openchoreov1alpha1.Endpoint{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "test-endpoint",
+			},
+			Spec: openchoreov1alpha1.EndpointSpec{
+				Type:       endpointType,
+				BackendRef: backendRef,
+			},
+		}
+	}
+
+	httpBackendRef := openchoreov1alpha1.BackendRef{
+		Type:     openchoreov1alpha1.BackendRefTypeComponentRef,
+		BasePath: "/api",
+		ComponentRef: &openchoreov1alpha1.ComponentRef{
+			Port: 8080,
+		},
+	}
+
+	DescribeTable("should build the address based on the endpoint and component type",
+		func(epCtx *dataplane.EndpointContext, gwType visibility.GatewayType, expectedAddress string) {
+			Expect(MakeAddress(epCtx, gwType)).To(Equal(expectedAddress))
+		},
+		Entry("for an HTTP endpoint of a service",
+			createTestEndpointContext(newEndpoint(openchoreov1alpha1.EndpointTypeHTTP, httpBackendRef),
+				"my-service", "prod", openchoreov1alpha1.ComponentTypeService),
+			visibility.GatewayExternal,
+			"https://prod.choreoapis.localhost/test-project/my-service",
+		),
+		Entry("for an HTTP endpoint of a service exposed to the organization",
+			createTestEndpointContext(newEndpoint(openchoreov1alpha1.EndpointTypeREST, httpBackendRef),
+				"my-service", "prod", openchoreov1alpha1.ComponentTypeService),
+			visibility.GatewayInternal,
+			"https://prod.internal.choreoapis.localhost/test-project/my-service",
+		),
+		Entry("for an HTTP endpoint of a web application",
+			createTestEndpointContext(newEndpoint(openchoreov1alpha1.EndpointTypeHTTP, httpBackendRef),
+				"my-webapp", "prod", openchoreov1alpha1.ComponentTypeWebApplication),
+			visibility.GatewayExternal,
+			"https://my-webapp-test-env.choreoapps.localhost/",
+		),
+		Entry("for a TCP endpoint without a component reference or base path",
+			createTestEndpointContext(newEndpoint(openchoreov1alpha1.EndpointTypeTCP, openchoreov1alpha1.BackendRef{
+				Type: openchoreov1alpha1.BackendRefTypeComponentRef,
+			}), "my-service", "prod", openchoreov1alpha1.ComponentTypeService),
+			visibility.GatewayExternal,
+			"",
+		),
+		Entry("for a UDP endpoint",
+			createTestEndpointContext(newEndpoint(openchoreov1alpha1.EndpointTypeUDP, httpBackendRef),
+				"my-service", "prod", openchoreov1alpha1.ComponentTypeService),
+			visibility.GatewayExternal,
+			"",
+		),
+	)
+
+	It("should not panic when the parent resources are missing from the context", func() {
+		epCtx := createTestEndpointContext(newEndpoint(openchoreov1alpha1.EndpointTypeHTTP, httpBackendRef),
+			"my-service", "prod", "")
+		epCtx.DataPlane = nil
+		Expect(MakeAddress(epCtx, visibility.GatewayExternal)).To(BeEmpty())
+
+		epCtx.Component = nil
+		Expect(MakeAddress(epCtx, visibility.GatewayExternal)).To(BeEmpty())
+
+		Expect(MakeAddress(&dataplane.EndpointContext{}, visibility.GatewayExternal)).To(BeEmpty())
+	})
+})
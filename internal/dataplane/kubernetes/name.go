@@ -30,6 +30,8 @@ const (
 
 // GenerateK8sName generates a Kubernetes-compliant name within the length limit,
 // ensuring uniqueness by appending a hash of the full concatenated names.
+// The hash is computed over the names as given (before sanitization), so inputs that only differ
+// by case or invalid characters (e.g. "my-app" and "My App!") still produce distinct names.
 // See https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#dns-subdomain-names
 // NOTE: Changes to this function will impact the generated names of all resources that can cause resource
 // recreation and stale resources in the k8s cluster.
@@ -62,6 +64,14 @@ func GenerateK8sNameWithLengthLimit(limit int, names ...string) string {
 	totalSeparatorLength += len(separator)
 
 	maxBaseNameLength := limit - hashLength - totalSeparatorLength
+	if maxBaseNameLength < 0 {
+		// There is no room for the name parts and the separators within the limit.
+		// Hence, only the hash is used so that the name is still unique.
+		if limit < hashLength {
+			return hashString[:max(limit, 0)]
+		}
+		return hashString
+	}
 
 	// Calculate maximum length for each name part
 	maxPartLength := maxBaseNameLength / numberOfNames
@@ -60,4 +60,52 @@ var _ = Describe("GenerateK8sName", func() {
 			"project-name-with-spaces-component-name-101f1326",
 		),
 	)
+
+	It("should generate distinct names for inputs that only differ after sanitization", func() {
+		lower := GenerateK8sName("my-app", "component")
+		mixedCase := GenerateK8sName("My-App", "component")
+		invalidChars := GenerateK8sName("My App!", "component")
+
+		// The sanitized base names are identical
+		Expect(lower).To(HavePrefix("my-app-component-"))
+		Expect(mixedCase).To(HavePrefix("my-app-component-"))
+		Expect(invalidChars).To(HavePrefix("my-app-component-"))
+
+		// The hash suffix is computed over the original input, so the full names differ
+		Expect(lower).NotTo(Equal(mixedCase))
+		Expect(lower).NotTo(Equal(invalidChars))
+		Expect(mixedCase).NotTo(Equal(invalidChars))
+	})
+
+	Describe("GenerateK8sNameWithLengthLimit", func() {
+		// hashSuffix returns the hash suffix of the name generated with the default length limit
+		hashSuffix := func(names ...string) string {
+			name := GenerateK8sName(names...)
+			return name[len(name)-hashLength:]
+		}
+
+		It("should honor the 63 character limit and keep the hash suffix", func() {
+			names := []string{strings.Repeat("organization", 10), strings.Repeat("project", 10), strings.Repeat("component", 10)}
+
+			generatedName := GenerateK8sNameWithLengthLimit(MaxLabelNameLength, names...)
+
+			Expect(len(generatedName)).To(BeNumerically("<=", MaxLabelNameLength))
+			Expect(generatedName).To(HaveSuffix("-" + hashSuffix(names...)))
+		})
+
+		DescribeTable("should fall back to the hash when the limit cannot fit the name parts",
+			func(limit int, expectedLength int) {
+				names := []string{"my-organization", "my-project", "component"}
+
+				generatedName := GenerateK8sNameWithLengthLimit(limit, names...)
+
+				Expect(generatedName).To(HaveLen(expectedLength))
+				Expect(hashSuffix(names...)).To(HavePrefix(generatedName))
+			},
+			Entry("for a limit that fits only the hash", 9, hashLength),
+			Entry("for a limit equal to the hash length", hashLength, hashLength),
+			Entry("for a limit shorter than the hash", 5, 5),
+			Entry("for a zero limit", 0, 0),
+		)
+	})
 })